@@ -0,0 +1,52 @@
+// Package authcrypto provides constant-time comparison helpers for
+// credentials, API keys and HMAC signatures. Unlike == and bytes.Equal,
+// they do not return early on the first mismatching byte.
+package authcrypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+)
+
+// Equal reports whether a and b are equal without exiting early on the
+// first mismatching byte.
+//
+// Both inputs are hashed to fixed 32-byte digests before comparing, so
+// subtle.ConstantTimeCompare never returns early on a length mismatch and
+// the length of a secret is not leaked by the comparison. Hashing itself
+// still takes time proportional to the input length.
+func Equal(a, b string) bool {
+	return EqualBytes([]byte(a), []byte(b))
+}
+
+// EqualBytes is the byte slice form of Equal.
+func EqualBytes(a, b []byte) bool {
+	ha := sha256.Sum256(a)
+	hb := sha256.Sum256(b)
+	return subtle.ConstantTimeCompare(ha[:], hb[:]) == 1
+}
+
+// SignHMAC returns the HMAC-SHA256 of msg under key.
+func SignHMAC(key, msg []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(msg)
+	return mac.Sum(nil)
+}
+
+// VerifyHMAC reports whether sig is a valid HMAC-SHA256 of msg under key.
+func VerifyHMAC(key, msg, sig []byte) bool {
+	return hmac.Equal(SignHMAC(key, msg), sig)
+}
+
+// VerifyHMACHex is like VerifyHMAC but takes a hex-encoded signature, as
+// commonly sent in webhook and request signing headers. Malformed hex is
+// treated as a mismatch.
+func VerifyHMACHex(key, msg []byte, sigHex string) bool {
+	sig, err := hex.DecodeString(sigHex)
+	if err != nil {
+		return false
+	}
+	return VerifyHMAC(key, msg, sig)
+}
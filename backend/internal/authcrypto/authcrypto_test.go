@@ -0,0 +1,104 @@
+package authcrypto
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"equal", "s3cret-api-key", "s3cret-api-key", true},
+		{"first byte mismatch", "s3cret-api-key", "x3cret-api-key", false},
+		{"last byte mismatch", "s3cret-api-key", "s3cret-api-kez", false},
+		{"different lengths", "s3cret-api-key", "s3cret-api-key-2", false},
+		{"empty vs non-empty", "", "s3cret-api-key", false},
+		{"both empty", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Equal(tt.a, tt.b); got != tt.want {
+				t.Errorf("Equal(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			if got := EqualBytes([]byte(tt.a), []byte(tt.b)); got != tt.want {
+				t.Errorf("EqualBytes(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyHMAC(t *testing.T) {
+	key := []byte("signing-key")
+	msg := []byte(`{"event":"user.created"}`)
+	sig := SignHMAC(key, msg)
+
+	tampered := append([]byte(nil), sig...)
+	tampered[0] ^= 0xff
+
+	tests := []struct {
+		name string
+		msg  []byte
+		sig  []byte
+		want bool
+	}{
+		{"valid", msg, sig, true},
+		{"tampered message", []byte(`{"event":"user.deleted"}`), sig, false},
+		{"tampered signature", msg, tampered, false},
+		{"truncated signature", msg, sig[:len(sig)-1], false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyHMAC(key, tt.msg, tt.sig); got != tt.want {
+				t.Errorf("VerifyHMAC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyHMACHex(t *testing.T) {
+	key := []byte("signing-key")
+	msg := []byte("payload")
+	sigHex := hex.EncodeToString(SignHMAC(key, msg))
+
+	tests := []struct {
+		name   string
+		sigHex string
+		want   bool
+	}{
+		{"valid", sigHex, true},
+		{"uppercase", strings.ToUpper(sigHex), true},
+		{"malformed", "zz" + sigHex[2:], false},
+		{"odd length", sigHex[1:], false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyHMACHex(key, msg, tt.sigHex); got != tt.want {
+				t.Errorf("VerifyHMACHex(%q) = %v, want %v", tt.sigHex, got, tt.want)
+			}
+		})
+	}
+}
+
+// The early- and late-mismatch benchmarks compare equal-length inputs and
+// should report roughly the same ns/op. A large gap means Equal has started
+// returning early on the first differing byte.
+
+var benchSecret = strings.Repeat("a", 64)
+
+func BenchmarkEqualEarlyMismatch(b *testing.B) {
+	other := "b" + benchSecret[1:]
+	for b.Loop() {
+		Equal(benchSecret, other)
+	}
+}
+
+func BenchmarkEqualLateMismatch(b *testing.B) {
+	other := benchSecret[:len(benchSecret)-1] + "b"
+	for b.Loop() {
+		Equal(benchSecret, other)
+	}
+}
@@ -0,0 +1,80 @@
+// Package requestctx provides typed accessors for request-scoped values
+// carried in a context.Context.
+//
+// Middleware sets these values once per request; services and repositories
+// read them through the getters instead of defining their own context keys.
+package requestctx
+
+import "context"
+
+type key int
+
+const (
+	requestIDKey key = iota
+	actorKey
+	tenantKey
+	localeKey
+	traceIDKey
+)
+
+// Principal identifies who is performing a request.
+type Principal struct {
+	ID   string
+	Kind string // e.g. "user", "service_account", "system"
+}
+
+// WithRequestID returns a copy of ctx carrying the request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestID returns the request ID stored in ctx, if any.
+func RequestID(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(requestIDKey).(string)
+	return v, ok
+}
+
+// WithActor returns a copy of ctx carrying the principal acting on the
+// request.
+func WithActor(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, actorKey, p)
+}
+
+// Actor returns the principal stored in ctx, if any.
+func Actor(ctx context.Context) (Principal, bool) {
+	v, ok := ctx.Value(actorKey).(Principal)
+	return v, ok
+}
+
+// WithTenant returns a copy of ctx carrying the tenant ID.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey, tenant)
+}
+
+// Tenant returns the tenant ID stored in ctx, if any.
+func Tenant(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(tenantKey).(string)
+	return v, ok
+}
+
+// WithLocale returns a copy of ctx carrying the locale tag (e.g. "jv-ID").
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey, locale)
+}
+
+// Locale returns the locale tag stored in ctx, if any.
+func Locale(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(localeKey).(string)
+	return v, ok
+}
+
+// WithTraceID returns a copy of ctx carrying the trace ID.
+func WithTraceID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, traceIDKey, id)
+}
+
+// TraceID returns the trace ID stored in ctx, if any.
+func TraceID(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(traceIDKey).(string)
+	return v, ok
+}
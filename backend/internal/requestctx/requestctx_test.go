@@ -0,0 +1,48 @@
+package requestctx
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStringValues(t *testing.T) {
+	tests := []struct {
+		name string
+		set  func(context.Context, string) context.Context
+		get  func(context.Context) (string, bool)
+	}{
+		{"RequestID", WithRequestID, RequestID},
+		{"Tenant", WithTenant, Tenant},
+		{"Locale", WithLocale, Locale},
+		{"TraceID", WithTraceID, TraceID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if v, ok := tt.get(context.Background()); ok || v != "" {
+				t.Errorf("get on empty context = (%q, %v), want (\"\", false)", v, ok)
+			}
+			ctx := tt.set(context.Background(), "value-1")
+			if v, ok := tt.get(ctx); !ok || v != "value-1" {
+				t.Errorf("get = (%q, %v), want (\"value-1\", true)", v, ok)
+			}
+		})
+	}
+}
+
+func TestActor(t *testing.T) {
+	if p, ok := Actor(context.Background()); ok || p != (Principal{}) {
+		t.Errorf("Actor on empty context = (%+v, %v), want zero, false", p, ok)
+	}
+	want := Principal{ID: "u-1", Kind: "user"}
+	ctx := WithActor(context.Background(), want)
+	if p, ok := Actor(ctx); !ok || p != want {
+		t.Errorf("Actor = (%+v, %v), want (%+v, true)", p, ok, want)
+	}
+}
+
+func TestKeysDoNotCollide(t *testing.T) {
+	ctx := WithRequestID(context.Background(), "req-1")
+	if _, ok := TraceID(ctx); ok {
+		t.Error("TraceID found in context that only carries a request ID")
+	}
+}